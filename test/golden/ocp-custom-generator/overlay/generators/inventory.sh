@@ -0,0 +1,36 @@
+# Example custom generator: records each OCP cluster in a hub-side inventory
+# ConfigMap and gives its default namespace a LimitRange.
+
+generate_inventory_configmap() {
+    cat > "$CLUSTER_OUTPUT_DIR/inventory.configmap.yaml" << EOF2
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: $FULL_CLUSTER_NAME-inventory
+  namespace: $FULL_CLUSTER_NAME
+data:
+  region: $REGION
+  type: $CLUSTER_TYPE
+EOF2
+    add_cluster_resource inventory.configmap.yaml
+}
+
+generate_default_limit_range() {
+    cat > "$CONFIGURATION_OUTPUT_DIR/default-limitrange.yaml" << EOF2
+apiVersion: v1
+kind: LimitRange
+metadata:
+  name: default-limits
+  namespace: default
+spec:
+  limits:
+  - type: Container
+    defaultRequest:
+      cpu: 100m
+      memory: 128Mi
+EOF2
+    CONFIGURATION_RESOURCES+=("default-limitrange.yaml")
+}
+
+register_generator cluster ocp generate_inventory_configmap
+register_generator configuration '*' generate_default_limit_range